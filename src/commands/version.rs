@@ -11,6 +11,25 @@ use serenity::{
 
 use crate::Config;
 
+// Both are baked in at compile time (see Dockerfile), so they always describe
+// the binary which is actually running, unlike SMYKLOT_VERSION.
+const COMMIT: Option<&str> = option_env!("SMYKLOT_COMMIT");
+const BUILD_DATE: Option<&str> = option_env!("SMYKLOT_BUILD_DATE");
+
+pub fn build_info(version: &str) -> String {
+    let version = match version {
+        "{{version}}" | "" => "¯\\_(ツ)_/¯",
+        _ => version
+    };
+
+    format!(
+        "{} (commit: {}, built: {})",
+        version,
+        COMMIT.unwrap_or("unknown"),
+        BUILD_DATE.unwrap_or("unknown"),
+    )
+}
+
 #[command]
 async fn version(ctx: &Context, msg: &Message) -> CommandResult {
     let config_lock = ctx.data.read().await
@@ -19,14 +38,8 @@ async fn version(ctx: &Context, msg: &Message) -> CommandResult {
         .clone();
 
     let config = config_lock.read().await;
-    let version = config.version.as_str();
-
-    let message = match version {
-        "{{version}}" | "" => "¯\\_(ツ)_/¯",
-        _ => version
-    };
 
-    msg.reply(ctx, message).await?;
+    msg.reply(ctx, build_info(&config.version)).await?;
 
     Ok(())
 }
@@ -231,13 +231,21 @@ async fn dispatch_error(ctx: &Context, msg: &Message, error: DispatchError) {
 async fn main() {
     env_logger::init();
 
+    let version = env::var("SMYKLOT_VERSION")
+        .unwrap_or(String::from("¯\\_(ツ)_/¯"));
+
+    // `smyklot version` only prints the build info, so it doesn't need
+    // a discord token
+    if env::args().nth(1).as_deref() == Some("version") {
+        println!("{}", build_info(&version));
+
+        return;
+    }
+
     let token = env::var("DISCORD_TOKEN").expect(
         "Expected a discord token in the environment - `DISCORD_TOKEN`",
     );
     
-    let version = env::var("SMYKLOT_VERSION")
-        .unwrap_or(String::from("¯\\_(ツ)_/¯"));
-    
     let http = Http::new_with_token(&token);
 
     // We will fetch your bot owners and id